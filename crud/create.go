@@ -0,0 +1,64 @@
+package crud
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Creatable defines the types that support creating an item from decoded
+// input. T is the type of the created item. In is the type of the decoded
+// request body.
+type Creatable[T any, In any] interface {
+	// Create persists a new item from in. Implementations should return
+	// ErrConflict if an equivalent item already exists.
+	Create(ctx context.Context, in In) (T, error)
+}
+
+// CreateHandler is a generic HTTP handler for creating an item.
+// It provides JSON body decoding and validation.
+type CreateHandler[T any, In any] struct {
+	querier  Creatable[T, In]    // The querier to persist data to the database
+	validate *validator.Validate // Validator for the decoded request body
+	writer   ResponseWriter      // Response writer interface
+}
+
+// NewCreateHandler creates a new CreateHandler with the provided querier and
+// response writer. It initializes the validator with default settings.
+func NewCreateHandler[T any, In any](querier Creatable[T, In], writer ResponseWriter) *CreateHandler[T, In] {
+	return &CreateHandler[T, In]{
+		querier:  querier,
+		validate: validator.New(validator.WithRequiredStructEnabled()),
+		writer:   writer,
+	}
+}
+
+// Handle processes HTTP requests for creating an item.
+func (h *CreateHandler[T, In]) Handle(w http.ResponseWriter, r *http.Request) {
+	var in In
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		slog.Error("unable to decode request body", "error", err)
+		h.writer.Error(w, ErrBadRequest, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.validate.Struct(in); err != nil {
+		slog.Error("unable to validate request body", "error", err)
+		h.writer.Error(w, &ValidationFailedError{Errors: ParseValidationErrors(err)}, http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.querier.Create(r.Context(), in)
+	if err != nil {
+		slog.Error("unable to create item", "error", err)
+		writeDomainError(h.writer, w, err)
+		return
+	}
+
+	if err := h.writer.Response(w, item, http.StatusCreated); err != nil {
+		slog.Error("unable to write response", "error", err)
+	}
+}
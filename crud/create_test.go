@@ -0,0 +1,94 @@
+package crud
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateTestInput is shared by the create/update/mount handler tests.
+type CreateTestInput struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// MockCreatable implements Creatable for testing.
+type MockCreatable[T any, In any] struct {
+	mock.Mock
+}
+
+func (m *MockCreatable[T, In]) Create(ctx context.Context, in In) (T, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(T), args.Error(1)
+}
+
+func TestCreateHandler_Handle_ValidRequest(t *testing.T) {
+	querier := &MockCreatable[string, CreateTestInput]{}
+	writer := &MockResponseWriter{}
+	handler := NewCreateHandler[string, CreateTestInput](querier, writer)
+
+	querier.On("Create", mock.Anything, CreateTestInput{Name: "widget"}).Return("item1", nil)
+	writer.On("Response", mock.Anything, "item1", http.StatusCreated).Return(nil)
+
+	req := httptest.NewRequest("POST", "/items", bytes.NewBufferString(`{"name":"widget"}`))
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+func TestCreateHandler_Handle_InvalidBody(t *testing.T) {
+	querier := &MockCreatable[string, CreateTestInput]{}
+	writer := &MockResponseWriter{}
+	handler := NewCreateHandler[string, CreateTestInput](querier, writer)
+
+	writer.On("Error", mock.Anything, ErrBadRequest, http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("POST", "/items", bytes.NewBufferString(`not json`))
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
+
+func TestCreateHandler_Handle_ValidationFailure(t *testing.T) {
+	querier := &MockCreatable[string, CreateTestInput]{}
+	writer := &MockResponseWriter{}
+	handler := NewCreateHandler[string, CreateTestInput](querier, writer)
+
+	writer.On("Error", mock.Anything, mock.MatchedBy(func(err *ValidationFailedError) bool {
+		return len(err.Errors) == 1 && err.Errors[0].Field == "Name"
+	}), http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("POST", "/items", bytes.NewBufferString(`{}`))
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
+
+func TestCreateHandler_Handle_Conflict(t *testing.T) {
+	querier := &MockCreatable[string, CreateTestInput]{}
+	writer := &MockResponseWriter{}
+	handler := NewCreateHandler[string, CreateTestInput](querier, writer)
+
+	querier.On("Create", mock.Anything, CreateTestInput{Name: "widget"}).Return("", ErrConflict)
+	writer.On("Error", mock.Anything, ErrConflict, http.StatusConflict).Return()
+
+	req := httptest.NewRequest("POST", "/items", bytes.NewBufferString(`{"name":"widget"}`))
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
@@ -0,0 +1,64 @@
+package crud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// CursorQuery represents the standard cursor-based pagination query
+// parameters, an alternative to PaginatedQuery for deep pagination over
+// large tables where OFFSET becomes prohibitive. PageSize is not
+// constrained by a struct tag because its bounds are configurable per
+// ListHandler via WithDefaultPageSize and WithMaxPageSize.
+type CursorQuery struct {
+	Cursor   string `form:"cursor"`    // opaque cursor token; empty for the first page
+	PageSize int    `form:"page_size"` // number of items per page; see WithDefaultPageSize, WithMaxPageSize
+}
+
+// Cursorable defines the types that support cursor-based listing.
+// T is the type of the items being listed.
+// Q is the type of the query parameters.
+//
+// The method is named ListByCursor, not List, because a querier commonly
+// implements both Listable and Cursorable: Go does not allow a type to
+// declare two methods named List with different signatures.
+type Cursorable[T any, Q any] interface {
+	// ListByCursor retrieves a slice of items starting after cursor, and
+	// returns the cursor to pass for the next page. nextCursor is empty
+	// when there are no more items.
+	ListByCursor(ctx context.Context, query Q, cursor string, limit int) (items []T, nextCursor string, err error)
+}
+
+// CursorToken is the decoded form of an opaque cursor token: the field the
+// result set is sorted by and the value of that field on the last item of
+// the previous page.
+type CursorToken struct {
+	SortKey string      `json:"sort_key"`
+	Value   interface{} `json:"value"`
+}
+
+// EncodeCursor serializes a CursorToken into an opaque base64 string
+// suitable for returning to clients as next_cursor.
+func EncodeCursor(t CursorToken) (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor parses an opaque cursor token produced by EncodeCursor.
+func DecodeCursor(cursor string) (CursorToken, error) {
+	var t CursorToken
+
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return t, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,62 @@
+package crud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEncodeDecodeCursor_RoundTrip(t *testing.T) {
+	token := CursorToken{SortKey: "created_at", Value: "2024-01-02T15:04:05Z"}
+
+	encoded, err := EncodeCursor(token)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, encoded)
+
+	decoded, err := DecodeCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, token, decoded)
+}
+
+func TestDecodeCursor_InvalidBase64(t *testing.T) {
+	_, err := DecodeCursor("not-valid-base64!!")
+	assert.Error(t, err)
+}
+
+func TestDecodeCursor_InvalidJSON(t *testing.T) {
+	// valid base64url, but not JSON
+	_, err := DecodeCursor("bm90LWpzb24=")
+	assert.Error(t, err)
+}
+
+type CursorTestQuery struct {
+	CursorQuery
+}
+
+func (q CursorTestQuery) GetCursor() CursorQuery {
+	return q.CursorQuery
+}
+
+func TestListHandler_Handle_CursorMode(t *testing.T) {
+	querier := &MockQuerier[string, CursorTestQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, CursorTestQuery](querier, writer)
+
+	items := []string{"item1", "item2"}
+	querier.On("ListByCursor", mock.Anything, mock.Anything, "", 10).Return(items, "next-cursor", nil)
+	writer.On("Response", mock.Anything, mock.MatchedBy(func(res map[string]interface{}) bool {
+		pagination, ok := res["pagination"].(map[string]interface{})
+		return ok && pagination["next_cursor"] == "next-cursor" && pagination["page_size"] == 10
+	}), http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("GET", "/?page_size=10", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
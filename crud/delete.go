@@ -0,0 +1,53 @@
+package crud
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// Deletable defines the types that support deleting an item by ID.
+// ID is the type of the item's identifier.
+type Deletable[ID any] interface {
+	// Delete removes the item identified by id. Implementations should
+	// return ErrNotFound when no item has that id.
+	Delete(ctx context.Context, id ID) error
+}
+
+// DeleteHandler is a generic HTTP handler for deleting an item.
+// It provides path-parameter ID extraction.
+type DeleteHandler[ID any] struct {
+	querier   Deletable[ID]   // The querier to remove data from the database
+	writer    ResponseWriter  // Response writer interface
+	extractID IDExtractor[ID] // Extracts the item ID from the request
+}
+
+// NewDeleteHandler creates a new DeleteHandler with the provided querier,
+// response writer, and ID extractor.
+func NewDeleteHandler[ID any](querier Deletable[ID], writer ResponseWriter, extractID IDExtractor[ID]) *DeleteHandler[ID] {
+	return &DeleteHandler[ID]{
+		querier:   querier,
+		writer:    writer,
+		extractID: extractID,
+	}
+}
+
+// Handle processes HTTP requests for deleting an item.
+func (h *DeleteHandler[ID]) Handle(w http.ResponseWriter, r *http.Request) {
+	id, err := h.extractID(r)
+	if err != nil {
+		slog.Error("unable to extract id", "error", err)
+		h.writer.Error(w, ErrBadRequest, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.querier.Delete(r.Context(), id); err != nil {
+		slog.Error("unable to delete item", "error", err, "id", id)
+		writeDomainError(h.writer, w, err)
+		return
+	}
+
+	if err := h.writer.Response(w, nil, http.StatusNoContent); err != nil {
+		slog.Error("unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,72 @@
+package crud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDeletable implements Deletable for testing.
+type MockDeletable[ID any] struct {
+	mock.Mock
+}
+
+func (m *MockDeletable[ID]) Delete(ctx context.Context, id ID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestDeleteHandler_Handle_ValidRequest(t *testing.T) {
+	querier := &MockDeletable[string]{}
+	writer := &MockResponseWriter{}
+	handler := NewDeleteHandler[string](querier, writer, extractIDString)
+
+	querier.On("Delete", mock.Anything, "42").Return(nil)
+	writer.On("Response", mock.Anything, nil, http.StatusNoContent).Return(nil)
+
+	req := httptest.NewRequest("DELETE", "/items/42", nil)
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+func TestDeleteHandler_Handle_ExtractIDError(t *testing.T) {
+	querier := &MockDeletable[string]{}
+	writer := &MockResponseWriter{}
+	handler := NewDeleteHandler[string](querier, writer, extractIDString)
+
+	writer.On("Error", mock.Anything, ErrBadRequest, http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("DELETE", "/items/", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
+
+func TestDeleteHandler_Handle_NotFound(t *testing.T) {
+	querier := &MockDeletable[string]{}
+	writer := &MockResponseWriter{}
+	handler := NewDeleteHandler[string](querier, writer, extractIDString)
+
+	querier.On("Delete", mock.Anything, "42").Return(ErrNotFound)
+	writer.On("Error", mock.Anything, ErrNotFound, http.StatusNotFound).Return()
+
+	req := httptest.NewRequest("DELETE", "/items/42", nil)
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
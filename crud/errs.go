@@ -1,6 +1,10 @@
 package crud
 
-import "errors"
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+)
 
 var (
 	ErrNotFound   = errors.New("not found")
@@ -8,3 +12,17 @@ var (
 	ErrBadRequest = errors.New("bad request")
 	ErrInternal   = errors.New("internal server error")
 )
+
+// writeDomainError maps ErrNotFound and ErrConflict to their HTTP status
+// codes and everything else to 500, via writer's Error contract.
+func writeDomainError(writer ResponseWriter, w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		writer.Error(w, err, http.StatusNotFound)
+	case errors.Is(err, ErrConflict):
+		writer.Error(w, err, http.StatusConflict)
+	default:
+		slog.Error("unhandled domain error", "error", err)
+		writer.Error(w, ErrInternal, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,60 @@
+package crud
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// IDExtractor extracts the resource ID from a request, typically from a
+// path parameter. It is a plain function type so the individual handler
+// constructors work with chi, gorilla/mux, or stdlib net/http (Go 1.22+
+// PathValue) without depending on any of them. Mount itself needs a router
+// that parses "METHOD pattern" registrations; see the Mux doc comment.
+type IDExtractor[ID any] func(*http.Request) (ID, error)
+
+// Gettable defines the types that support fetching a single item by ID.
+// T is the type of the item. ID is the type of its identifier.
+type Gettable[T any, ID any] interface {
+	// Get retrieves a single item by id. Implementations should return
+	// ErrNotFound when no item has that id.
+	Get(ctx context.Context, id ID) (T, error)
+}
+
+// GetHandler is a generic HTTP handler for fetching a single item by ID.
+type GetHandler[T any, ID any] struct {
+	querier   Gettable[T, ID] // The querier to fetch data from the database
+	writer    ResponseWriter  // Response writer interface
+	extractID IDExtractor[ID] // Extracts the item ID from the request
+}
+
+// NewGetHandler creates a new GetHandler with the provided querier, response
+// writer, and ID extractor.
+func NewGetHandler[T any, ID any](querier Gettable[T, ID], writer ResponseWriter, extractID IDExtractor[ID]) *GetHandler[T, ID] {
+	return &GetHandler[T, ID]{
+		querier:   querier,
+		writer:    writer,
+		extractID: extractID,
+	}
+}
+
+// Handle processes HTTP requests for fetching a single item.
+func (h *GetHandler[T, ID]) Handle(w http.ResponseWriter, r *http.Request) {
+	id, err := h.extractID(r)
+	if err != nil {
+		slog.Error("unable to extract id", "error", err)
+		h.writer.Error(w, ErrBadRequest, http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.querier.Get(r.Context(), id)
+	if err != nil {
+		slog.Error("unable to get item", "error", err, "id", id)
+		writeDomainError(h.writer, w, err)
+		return
+	}
+
+	if err := h.writer.Response(w, item, http.StatusOK); err != nil {
+		slog.Error("unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,84 @@
+package crud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGettable implements Gettable for testing.
+type MockGettable[T any, ID any] struct {
+	mock.Mock
+}
+
+func (m *MockGettable[T, ID]) Get(ctx context.Context, id ID) (T, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(T), args.Error(1)
+}
+
+// extractIDString is a simple IDExtractor shared by the handler tests: it
+// reads "id" from the request's path value, as a stdlib net/http (Go 1.22+)
+// route would populate it.
+func extractIDString(r *http.Request) (string, error) {
+	id := r.PathValue("id")
+	if id == "" {
+		return "", errors.New("missing id")
+	}
+	return id, nil
+}
+
+func TestGetHandler_Handle_ValidRequest(t *testing.T) {
+	querier := &MockGettable[string, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewGetHandler[string, string](querier, writer, extractIDString)
+
+	querier.On("Get", mock.Anything, "42").Return("item1", nil)
+	writer.On("Response", mock.Anything, "item1", http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("GET", "/items/42", nil)
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+func TestGetHandler_Handle_NotFound(t *testing.T) {
+	querier := &MockGettable[string, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewGetHandler[string, string](querier, writer, extractIDString)
+
+	querier.On("Get", mock.Anything, "42").Return("", ErrNotFound)
+	writer.On("Error", mock.Anything, ErrNotFound, http.StatusNotFound).Return()
+
+	req := httptest.NewRequest("GET", "/items/42", nil)
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+func TestGetHandler_Handle_ExtractIDError(t *testing.T) {
+	querier := &MockGettable[string, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewGetHandler[string, string](querier, writer, extractIDString)
+
+	writer.On("Error", mock.Anything, ErrBadRequest, http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("GET", "/items/", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
@@ -2,18 +2,22 @@ package crud
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-playground/form/v4"
 	"github.com/go-playground/validator/v10"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 )
 
 // PaginatedQuery represents the standard pagination query parameters.
-// It requires page number and page size through form validation.
+// It requires a page number through form validation. PageSize is not
+// constrained by a struct tag because its bounds are configurable per
+// ListHandler via WithDefaultPageSize and WithMaxPageSize.
 type PaginatedQuery struct {
-	Page     int `form:"page" validate:"required,min=1"`              // Page number, starting from 1
-	PageSize int `form:"page_size" validate:"required,min=1,max=100"` // Number of items per page, max 100
+	Page     int `form:"page" validate:"required,min=1"` // Page number, starting from 1
+	PageSize int `form:"page_size"`                      // Number of items per page; see WithDefaultPageSize, WithMaxPageSize
 }
 
 // Listable defines the types that support listing and counting operations.
@@ -34,38 +38,95 @@ type ListHandler[T any, Q any] struct {
 	decoder  *form.Decoder       // Decoder for query parameters
 	validate *validator.Validate // Validator for query parameters
 	writer   ResponseWriter      // Response writer interface
+
+	timeout         time.Duration                     // Timeout applied to the request context
+	maxPageSize     int                               // Largest page_size Handle accepts
+	defaultPageSize int                               // page_size used when the query omits it
+	middleware      []func(http.Handler) http.Handler // Middleware chain wrapping Handle
+	handler         http.Handler                      // Handle's core logic wrapped in middleware
 }
 
 // NewListHandler creates a new ListHandler with the provided querier and response writer.
-// It initializes the decoder and validator with default settings.
-func NewListHandler[T any, Q any](querier Listable[T, Q], writer ResponseWriter) *ListHandler[T, Q] {
-	return &ListHandler[T, Q]{
-		querier:  querier,
-		decoder:  form.NewDecoder(),
-		validate: validator.New(validator.WithRequiredStructEnabled()),
-		writer:   writer,
+// It initializes the decoder and validator with default settings, which can be
+// overridden with options such as WithTimeout, WithMiddleware, WithMaxPageSize,
+// and WithDefaultPageSize.
+func NewListHandler[T any, Q any](querier Listable[T, Q], writer ResponseWriter, opts ...ListHandlerOption[T, Q]) *ListHandler[T, Q] {
+	h := &ListHandler[T, Q]{
+		querier:         querier,
+		decoder:         form.NewDecoder(),
+		validate:        validator.New(validator.WithRequiredStructEnabled()),
+		writer:          writer,
+		timeout:         defaultTimeout,
+		maxPageSize:     defaultMaxPageSize,
+		defaultPageSize: defaultDefaultPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(h)
 	}
+
+	var wrapped http.Handler = http.HandlerFunc(h.handle)
+	for i := len(h.middleware) - 1; i >= 0; i-- {
+		wrapped = h.middleware[i](wrapped)
+	}
+	h.handler = wrapped
+
+	return h
+}
+
+// resolvePageSize defaults pageSize to h.defaultPageSize when unset and
+// rejects values outside h.maxPageSize, since PaginatedQuery and
+// CursorQuery no longer enforce those bounds via struct tags.
+func (h *ListHandler[T, Q]) resolvePageSize(pageSize int) (int, *ValidationFailedError) {
+	if pageSize == 0 {
+		pageSize = h.defaultPageSize
+	}
+
+	if pageSize < 1 || pageSize > h.maxPageSize {
+		return 0, &ValidationFailedError{Errors: []ValidationError{{
+			Field:   "page_size",
+			Tag:     "max",
+			Message: fmt.Sprintf("page_size must be between 1 and %d", h.maxPageSize),
+			Param:   strconv.Itoa(h.maxPageSize),
+		}}}
+	}
+
+	return pageSize, nil
 }
 
 // Handle processes HTTP requests for listing operations.
 // It performs the following steps:
-// 1. Decodes query parameters from the request.
-// 2. Validates the query parameters.
-// 3. Handles pagination if the query implements GetPagination().
-// 4. Fetches the data from the querier.
-// 5. Counts the total number of items.
-// 6. Writes the response with the paginated data.
+//  1. Decodes query parameters from the request.
+//  2. Validates the query parameters.
+//  3. Parses sort and sparse-fieldset parameters if Q implements GetSort().
+//  4. Lists in cursor mode if Q implements GetCursor(), or offset/limit mode
+//     if Q implements GetPagination().
+//  5. Counts the total number of items (offset/limit mode only; cursor mode
+//     skips this, since counting the full result set defeats its purpose).
+//  6. Writes the response, projected to "fields" if requested.
 //
-// Teh response format is:
+// In offset/limit mode, the response format is:
 //
 //	{
 //		"items": [...],
 //		"pagination": {
-//		"total": n,
-//		"page": x
-//		"page_size": y
+//			"total": n,
+//			"page": x,
+//			"page_size": y
+//		},
+//		"links": {"self": "...", "first": "...", "prev": "...", "next": "...", "last": "..."}
 //	}
+//
+// "page" and "links" are omitted if Q doesn't implement GetPagination(). In
+// cursor mode, "pagination" is instead {"next_cursor": "...", "page_size": y}
+// and "links" is never present.
 func (h *ListHandler[T, Q]) Handle(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+// handle contains Handle's core listing logic, run after any configured
+// middleware.
+func (h *ListHandler[T, Q]) handle(w http.ResponseWriter, r *http.Request) {
 	startTime := time.Now()
 	slog.Debug("list.start",
 		"method", r.Method,
@@ -74,8 +135,7 @@ func (h *ListHandler[T, Q]) Handle(w http.ResponseWriter, r *http.Request) {
 	)
 
 	// set timeout for the entire request
-	// TODO: make this configurable via env var
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	defer cancel()
 
 	// decode the query params
@@ -89,25 +149,131 @@ func (h *ListHandler[T, Q]) Handle(w http.ResponseWriter, r *http.Request) {
 
 	// validate the query params
 	if err := h.validate.Struct(query); err != nil {
-		//validationErrors := ParseValidationErrors(err)
-		//res := map[string][]ValidationError{
-		//	"errors": validationErrors,
-		//}
 		slog.Error("unable to validate query params", "error", err)
 		slog.Debug("query params decoding", "query_params", r.URL.Query())
-		h.writer.Error(w, ErrBadRequest, http.StatusBadRequest)
+		h.writer.Error(w, &ValidationFailedError{Errors: ParseValidationErrors(err)}, http.StatusBadRequest)
+		return
+	}
+
+	// sorting and sparse fieldsets: Q opts in via GetSort(). The field
+	// whitelist comes from the querier's SortableFields(), since only it
+	// knows which fields are safe (e.g. indexed) to sort by.
+	var sortSpecs []SortSpec
+	var fields []string
+	sortRequested := false
+	if sq, ok := any(query).(interface{ GetSort() SortableQuery }); ok {
+		sortRequested = true
+		sortable, ok := any(h.querier).(SortableFields)
+		if !ok {
+			slog.Error("querier does not support sorting")
+			h.writer.Error(w, ErrInternal, http.StatusInternalServerError)
+			return
+		}
+
+		sortQuery := sq.GetSort()
+		specs, invalidField, err := parseSortSpecs(sortQuery.Sort, sortable.SortableFields())
+		if err != nil {
+			h.writer.Error(w, &ValidationFailedError{Errors: []ValidationError{{
+				Field:   "sort",
+				Tag:     "oneof",
+				Message: err.Error(),
+				Param:   invalidField,
+			}}}, http.StatusBadRequest)
+			return
+		}
+
+		sortSpecs = specs
+		fields = parseFields(sortQuery.Fields)
+	}
+
+	// cursor mode: Q opts in via GetCursor() instead of GetPagination().
+	// It skips the Count call entirely, since counting the full result
+	// set defeats the purpose of cursor pagination over large tables.
+	if cq, ok := any(query).(interface{ GetCursor() CursorQuery }); ok {
+		// Cursorable has no sort parameter, so rather than silently
+		// dropping an explicit sort request, reject it outright.
+		if len(sortSpecs) > 0 {
+			h.writer.Error(w, &ValidationFailedError{Errors: []ValidationError{{
+				Field:   "sort",
+				Tag:     "unsupported",
+				Message: "sort is not supported together with cursor pagination",
+			}}}, http.StatusBadRequest)
+			return
+		}
+
+		cursorQuerier, ok := any(h.querier).(Cursorable[T, Q])
+		if !ok {
+			slog.Error("querier does not support cursor pagination")
+			h.writer.Error(w, ErrInternal, http.StatusInternalServerError)
+			return
+		}
+
+		cursor := cq.GetCursor()
+		pageSize, verr := h.resolvePageSize(cursor.PageSize)
+		if verr != nil {
+			h.writer.Error(w, verr, http.StatusBadRequest)
+			return
+		}
+		cursor.PageSize = pageSize
+
+		items, nextCursor, err := cursorQuerier.ListByCursor(ctx, query, cursor.Cursor, cursor.PageSize)
+		if err != nil {
+			slog.Error("unable to list items", "error", err)
+			slog.Debug("listing", "query", query, "cursor", cursor.Cursor, "page_size", cursor.PageSize)
+			h.writer.Error(w, ErrInternal, http.StatusInternalServerError)
+			return
+		}
+
+		projectedItems, err := applyFieldSelection(items, fields)
+		if err != nil {
+			slog.Error("unable to apply field selection", "error", err)
+			h.writer.Error(w, ErrInternal, http.StatusInternalServerError)
+			return
+		}
+
+		res := map[string]interface{}{
+			"items": projectedItems,
+			"pagination": map[string]interface{}{
+				"next_cursor": nextCursor,
+				"page_size":   cursor.PageSize,
+			},
+		}
+		if err := h.writer.Response(w, res, http.StatusOK); err != nil {
+			slog.Error("unable to write response", "error", err)
+			slog.Debug("writing response", "response", res)
+		}
+
+		slog.Debug("list.complete", "duration", time.Since(startTime))
 		return
 	}
 
 	var offset, limit int
 	if pg, ok := any(query).(interface{ GetPagination() PaginatedQuery }); ok {
 		pagination := pg.GetPagination()
-		offset = (pagination.Page - 1) * pagination.PageSize
-		limit = pagination.PageSize
+		pageSize, verr := h.resolvePageSize(pagination.PageSize)
+		if verr != nil {
+			h.writer.Error(w, verr, http.StatusBadRequest)
+			return
+		}
+		limit = pageSize
+		offset = (pagination.Page - 1) * limit
 	}
 
-	// list the items with pagination
-	items, err := h.querier.List(ctx, query, offset, limit)
+	// list the items with pagination, using the sort-aware querier when
+	// the query requested sorting
+	var items []T
+	var err error
+	if sortRequested {
+		sortedQuerier, ok := any(h.querier).(SortableListable[T, Q])
+		if !ok {
+			slog.Error("querier does not support sorting")
+			h.writer.Error(w, ErrInternal, http.StatusInternalServerError)
+			return
+		}
+		items, err = sortedQuerier.ListSorted(ctx, query, offset, limit, sortSpecs)
+	} else {
+		items, err = h.querier.List(ctx, query, offset, limit)
+	}
 	if err != nil {
 		slog.Error("unable to list items", "error", err)
 		slog.Debug("listing", "query", query, "offset", offset, "limit", limit)
@@ -124,15 +290,36 @@ func (h *ListHandler[T, Q]) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// write the response
+	projectedItems, err := applyFieldSelection(items, fields)
+	if err != nil {
+		slog.Error("unable to apply field selection", "error", err)
+		h.writer.Error(w, ErrInternal, http.StatusInternalServerError)
+		return
+	}
+
+	// write the response. "page" and "links" only make sense when limit is
+	// known, i.e. when Q implements GetPagination(); both are omitted
+	// otherwise rather than computing offset/limit and dividing by zero.
+	pagination := map[string]interface{}{
+		"total":     total,
+		"page_size": limit,
+	}
+
 	res := map[string]interface{}{
-		"items": items,
-		"pagination": map[string]interface{}{
-			"total":     total,
-			"page":      offset/limit + 1,
-			"page_size": limit,
-		},
+		"items":      projectedItems,
+		"pagination": pagination,
 	}
+
+	if limit > 0 {
+		page := offset/limit + 1
+		pagination["page"] = page
+
+		totalPages := int((total + int64(limit) - 1) / int64(limit))
+		links := buildPaginationLinks(r, page, limit, totalPages)
+		res["links"] = links
+		w.Header().Set("Link", links.Header())
+	}
+
 	err = h.writer.Response(w, res, http.StatusOK)
 	if err != nil {
 		slog.Error("unable to write response", "error", err)
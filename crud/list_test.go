@@ -2,6 +2,7 @@ package crud
 
 import (
 	"context"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"net/http"
@@ -24,6 +25,24 @@ func (m *MockQuerier[T, Q]) Count(ctx context.Context, query Q) (int64, error) {
 	return args.Get(0).(int64), args.Error(1)
 }
 
+// ListByCursor implements Cursorable for testing.
+func (m *MockQuerier[T, Q]) ListByCursor(ctx context.Context, query Q, cursor string, limit int) ([]T, string, error) {
+	args := m.Called(ctx, query, cursor, limit)
+	return args.Get(0).([]T), args.String(1), args.Error(2)
+}
+
+// SortableFields implements SortableFields for testing.
+func (m *MockQuerier[T, Q]) SortableFields() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+// ListSorted implements SortableListable for testing.
+func (m *MockQuerier[T, Q]) ListSorted(ctx context.Context, query Q, offset int, limit int, sort []SortSpec) ([]T, error) {
+	args := m.Called(ctx, query, offset, limit, sort)
+	return args.Get(0).([]T), args.Error(1)
+}
+
 type MockResponseWriter struct {
 	mock.Mock
 }
@@ -84,7 +103,14 @@ func TestListHandler_Handle_InvalidQuery(t *testing.T) {
 	writer := &MockResponseWriter{}
 	handler := NewListHandler[string, TestQuery](querier, writer)
 
-	writer.On("Error", mock.Anything, ErrBadRequest, http.StatusBadRequest).Return()
+	writer.On("Error", mock.Anything, mock.MatchedBy(func(err *ValidationFailedError) bool {
+		for _, fieldErr := range err.Errors {
+			if fieldErr.Field == "Page" && fieldErr.Tag == "required" {
+				return true
+			}
+		}
+		return false
+	}), http.StatusBadRequest).Return()
 
 	req := httptest.NewRequest("GET", "/?page=0&page_size=0", nil)
 	w := httptest.NewRecorder()
@@ -111,6 +137,122 @@ func TestListHandler_Handle_QuerierError(t *testing.T) {
 	writer.AssertExpectations(t)
 }
 
+// PlainQuery doesn't implement GetPagination, so ListHandler falls back to
+// offset 0, limit 0.
+type PlainQuery struct {
+	Name string `form:"name"`
+}
+
+// TestListHandler_Handle_NoPagination ensures a query without GetPagination
+// doesn't panic computing "page" from a zero limit, and that "page" and
+// "links" are both omitted rather than reporting bogus values.
+func TestListHandler_Handle_NoPagination(t *testing.T) {
+	querier := &MockQuerier[string, PlainQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, PlainQuery](querier, writer)
+
+	querier.On("List", mock.Anything, mock.Anything, 0, 0).Return([]string{"item1"}, nil)
+	querier.On("Count", mock.Anything, mock.Anything).Return(int64(1), nil)
+	writer.On("Response", mock.Anything, mock.MatchedBy(func(res map[string]interface{}) bool {
+		pagination, ok := res["pagination"].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		_, hasPage := pagination["page"]
+		_, hasLinks := res["links"]
+		return !hasPage && !hasLinks
+	}), http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("GET", "/?name=test", nil)
+	w := httptest.NewRecorder()
+
+	assert.NotPanics(t, func() { handler.Handle(w, req) })
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+// TestListHandler_Handle_Links_MiddlePage hits page 2 of 3 and asserts all
+// five relation links are populated, including the Link response header.
+func TestListHandler_Handle_Links_MiddlePage(t *testing.T) {
+	querier := &MockQuerier[string, TestQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, TestQuery](querier, writer)
+
+	querier.On("List", mock.Anything, mock.Anything, 10, 10).Return([]string{"item1"}, nil)
+	querier.On("Count", mock.Anything, mock.Anything).Return(int64(30), nil)
+
+	var gotLinks PaginationLinks
+	writer.On("Response", mock.Anything, mock.MatchedBy(func(res map[string]interface{}) bool {
+		links, ok := res["links"].(PaginationLinks)
+		if ok {
+			gotLinks = links
+		}
+		return ok
+	}), http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("GET", "http://example.com/items?page=2&page_size=10", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	assert.Contains(t, gotLinks.Self, "page=2")
+	assert.Contains(t, gotLinks.First, "page=1")
+	assert.Contains(t, gotLinks.Prev, "page=1")
+	assert.Contains(t, gotLinks.Next, "page=3")
+	assert.Contains(t, gotLinks.Last, "page=3")
+	assert.NotEmpty(t, w.Header().Get("Link"))
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+// TestListHandler_Handle_Links_Boundaries confirms prev/next are omitted at
+// the first and last page respectively.
+func TestListHandler_Handle_Links_Boundaries(t *testing.T) {
+	cases := []struct {
+		name     string
+		page     int
+		wantPrev bool
+		wantNext bool
+	}{
+		{"first page", 1, false, true},
+		{"last page", 3, true, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			querier := &MockQuerier[string, TestQuery]{}
+			writer := &MockResponseWriter{}
+			handler := NewListHandler[string, TestQuery](querier, writer)
+
+			offset := (tc.page - 1) * 10
+			querier.On("List", mock.Anything, mock.Anything, offset, 10).Return([]string{"item1"}, nil)
+			querier.On("Count", mock.Anything, mock.Anything).Return(int64(30), nil)
+
+			var gotLinks PaginationLinks
+			writer.On("Response", mock.Anything, mock.MatchedBy(func(res map[string]interface{}) bool {
+				links, ok := res["links"].(PaginationLinks)
+				if ok {
+					gotLinks = links
+				}
+				return ok
+			}), http.StatusOK).Return(nil)
+
+			req := httptest.NewRequest("GET", fmt.Sprintf("http://example.com/items?page=%d&page_size=10", tc.page), nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			assert.Equal(t, tc.wantPrev, gotLinks.Prev != "")
+			assert.Equal(t, tc.wantNext, gotLinks.Next != "")
+
+			querier.AssertExpectations(t)
+			writer.AssertExpectations(t)
+		})
+	}
+}
+
 //func TestListHandler_Handle_Timeout(t *testing.T) {
 //	querier := &MockQuerier[string, TestQuery]{}
 //	writer := &MockResponseWriter{}
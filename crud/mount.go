@@ -0,0 +1,45 @@
+package crud
+
+import "net/http"
+
+// Mux is the minimal router interface Mount needs to register routes. Mount
+// registers patterns with a "METHOD " prefix (e.g. "GET /widgets/{id}"), so
+// mux must parse that prefix the way *http.ServeMux does (Go 1.22+). chi and
+// gorilla/mux do not: they expose their own per-method registration methods
+// instead, so a *chi.Mux or *mux.Router passed here would silently register
+// unreachable routes.
+type Mux interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// Resource bundles the five querier interfaces a RESTful resource needs:
+// List, Get, Create, Update, and Delete.
+type Resource[T any, Q any, In any, ID any] interface {
+	Listable[T, Q]
+	Gettable[T, ID]
+	Creatable[T, In]
+	Updatable[T, In, ID]
+	Deletable[ID]
+}
+
+// Mount registers List and Create on basePath, and Get/Update/Delete on
+// basePath+"/{id}", against mux. It is the natural completion of the
+// package's scope: wiring all five CRUD handlers for a resource in one
+// call instead of registering each by hand. mux must be a *http.ServeMux
+// (Go 1.22+) or another router that parses the same "METHOD pattern"
+// convention; see the Mux doc comment.
+func Mount[T any, Q any, In any, ID any](
+	mux Mux,
+	basePath string,
+	querier Resource[T, Q, In, ID],
+	writer ResponseWriter,
+	extractID IDExtractor[ID],
+) {
+	itemPath := basePath + "/{id}"
+
+	mux.Handle("GET "+basePath, http.HandlerFunc(NewListHandler[T, Q](querier, writer).Handle))
+	mux.Handle("POST "+basePath, http.HandlerFunc(NewCreateHandler[T, In](querier, writer).Handle))
+	mux.Handle("GET "+itemPath, http.HandlerFunc(NewGetHandler[T, ID](querier, writer, extractID).Handle))
+	mux.Handle("PUT "+itemPath, http.HandlerFunc(NewUpdateHandler[T, In, ID](querier, writer, extractID).Handle))
+	mux.Handle("DELETE "+itemPath, http.HandlerFunc(NewDeleteHandler[ID](querier, writer, extractID).Handle))
+}
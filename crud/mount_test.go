@@ -0,0 +1,78 @@
+package crud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mountTestResource is a minimal in-memory Resource[T,Q,In,ID] used to
+// confirm Mount wires all five routes onto a real router.
+type mountTestResource struct{}
+
+func (mountTestResource) List(ctx context.Context, query TestQuery, offset, limit int) ([]string, error) {
+	return []string{"item1"}, nil
+}
+
+func (mountTestResource) Count(ctx context.Context, query TestQuery) (int64, error) {
+	return 1, nil
+}
+
+func (mountTestResource) Get(ctx context.Context, id string) (string, error) {
+	return "item1", nil
+}
+
+func (mountTestResource) Create(ctx context.Context, in CreateTestInput) (string, error) {
+	return "item1", nil
+}
+
+func (mountTestResource) Update(ctx context.Context, id string, in CreateTestInput) (string, error) {
+	return "item1", nil
+}
+
+func (mountTestResource) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+// TestMount_RegistersAllRoutes confirms Mount wires all five CRUD routes
+// onto a *http.ServeMux, the router Mount documents support for: a *chi.Mux
+// or *mux.Router would leave these routes unreachable, since neither parses
+// the "METHOD pattern" prefix Mount registers with.
+func TestMount_RegistersAllRoutes(t *testing.T) {
+	mux := http.NewServeMux()
+	Mount[string, TestQuery, CreateTestInput, string](
+		mux, "/items", mountTestResource{}, &DefaultResponseWriter{}, extractIDString,
+	)
+
+	cases := []struct {
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{"GET", "/items?page=1&page_size=10", "", http.StatusOK},
+		{"POST", "/items", `{"name":"widget"}`, http.StatusCreated},
+		{"GET", "/items/1", "", http.StatusOK},
+		{"PUT", "/items/1", `{"name":"widget"}`, http.StatusOK},
+		{"DELETE", "/items/1", "", http.StatusNoContent},
+	}
+
+	for _, tc := range cases {
+		var body io.Reader
+		if tc.body != "" {
+			body = strings.NewReader(tc.body)
+		}
+
+		req := httptest.NewRequest(tc.method, tc.path, body)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.want, w.Code, "%s %s", tc.method, tc.path)
+	}
+}
@@ -0,0 +1,47 @@
+package crud
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	defaultTimeout         = 30 * time.Second
+	defaultMaxPageSize     = 100
+	defaultDefaultPageSize = 20
+)
+
+// ListHandlerOption configures a ListHandler constructed by NewListHandler.
+type ListHandlerOption[T any, Q any] func(*ListHandler[T, Q])
+
+// WithTimeout sets the timeout applied to the request context for the
+// lifetime of Handle. The default is 30 seconds.
+func WithTimeout[T any, Q any](d time.Duration) ListHandlerOption[T, Q] {
+	return func(h *ListHandler[T, Q]) {
+		h.timeout = d
+	}
+}
+
+// WithMiddleware wraps Handle with mw, applied in the order given so the
+// first middleware is outermost (runs first on the way in).
+func WithMiddleware[T any, Q any](mw ...func(http.Handler) http.Handler) ListHandlerOption[T, Q] {
+	return func(h *ListHandler[T, Q]) {
+		h.middleware = append(h.middleware, mw...)
+	}
+}
+
+// WithMaxPageSize overrides the maximum page_size Handle accepts. The
+// default is 100.
+func WithMaxPageSize[T any, Q any](n int) ListHandlerOption[T, Q] {
+	return func(h *ListHandler[T, Q]) {
+		h.maxPageSize = n
+	}
+}
+
+// WithDefaultPageSize overrides the page_size Handle uses when the query
+// omits it. The default is 20.
+func WithDefaultPageSize[T any, Q any](n int) ListHandlerOption[T, Q] {
+	return func(h *ListHandler[T, Q]) {
+		h.defaultPageSize = n
+	}
+}
@@ -0,0 +1,77 @@
+package crud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWithMaxPageSize_RejectsOversizedPageSize(t *testing.T) {
+	querier := &MockQuerier[string, TestQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, TestQuery](querier, writer, WithMaxPageSize[string, TestQuery](5))
+
+	writer.On("Error", mock.Anything, mock.MatchedBy(func(err *ValidationFailedError) bool {
+		return len(err.Errors) == 1 && err.Errors[0].Field == "page_size"
+	}), http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("GET", "/?page=1&page_size=10", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
+
+func TestWithDefaultPageSize_AppliesWhenPageSizeOmitted(t *testing.T) {
+	querier := &MockQuerier[string, TestQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, TestQuery](querier, writer, WithDefaultPageSize[string, TestQuery](7))
+
+	querier.On("List", mock.Anything, mock.Anything, 0, 7).Return([]string{}, nil)
+	querier.On("Count", mock.Anything, mock.Anything).Return(int64(0), nil)
+	writer.On("Response", mock.Anything, mock.Anything, http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("GET", "/?page=1", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+// TestWithMiddleware_OuterToInner asserts the first middleware passed to
+// WithMiddleware runs first on the way in, matching its doc comment.
+func TestWithMiddleware_OuterToInner(t *testing.T) {
+	var order []string
+	mw := func(name string) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	querier := &MockQuerier[string, TestQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, TestQuery](querier, writer,
+		WithMiddleware[string, TestQuery](mw("outer"), mw("inner")),
+	)
+
+	querier.On("List", mock.Anything, mock.Anything, 0, 20).Return([]string{}, nil)
+	querier.On("Count", mock.Anything, mock.Anything).Return(int64(0), nil)
+	writer.On("Response", mock.Anything, mock.Anything, http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("GET", "/?page=1", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	assert.Equal(t, []string{"outer", "inner"}, order)
+}
@@ -0,0 +1,85 @@
+package crud
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// PaginationLinks holds RFC 5988-style relation links for a paginated
+// list response.
+type PaginationLinks struct {
+	Self  string `json:"self"`
+	First string `json:"first"`
+	Last  string `json:"last,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// Header renders the links as a single RFC 5988 Link header value,
+// e.g. `<url>; rel="self", <url>; rel="next"`.
+func (l PaginationLinks) Header() string {
+	parts := make([]string, 0, 5)
+	parts = append(parts, fmt.Sprintf(`<%s>; rel="self"`, l.Self))
+	parts = append(parts, fmt.Sprintf(`<%s>; rel="first"`, l.First))
+	if l.Prev != "" {
+		parts = append(parts, fmt.Sprintf(`<%s>; rel="prev"`, l.Prev))
+	}
+	if l.Next != "" {
+		parts = append(parts, fmt.Sprintf(`<%s>; rel="next"`, l.Next))
+	}
+	if l.Last != "" {
+		parts = append(parts, fmt.Sprintf(`<%s>; rel="last"`, l.Last))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// buildPaginationLinks reconstructs r's URL for each relevant page, omitting
+// prev/next at the boundaries. totalPages of 0 means the total is unknown
+// (or there are no results), so last is omitted too.
+func buildPaginationLinks(r *http.Request, page, pageSize, totalPages int) PaginationLinks {
+	links := PaginationLinks{
+		Self:  paginationLink(r, page, pageSize),
+		First: paginationLink(r, 1, pageSize),
+	}
+
+	if totalPages > 0 {
+		links.Last = paginationLink(r, totalPages, pageSize)
+	}
+	if page > 1 {
+		links.Prev = paginationLink(r, page-1, pageSize)
+	}
+	if totalPages > 0 && page < totalPages {
+		links.Next = paginationLink(r, page+1, pageSize)
+	}
+
+	return links
+}
+
+// paginationLink reconstructs the request URL with page and page_size
+// replaced, leaving every other query parameter untouched.
+func paginationLink(r *http.Request, page, pageSize int) string {
+	u := *r.URL
+	u.Scheme = requestScheme(r)
+	u.Host = r.Host
+
+	q := u.Query()
+	q.Set("page", strconv.Itoa(page))
+	q.Set("page_size", strconv.Itoa(pageSize))
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// requestScheme infers the scheme of r, honoring X-Forwarded-Proto for
+// requests behind a reverse proxy.
+func requestScheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		return proto
+	}
+	return "http"
+}
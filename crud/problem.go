@@ -0,0 +1,115 @@
+package crud
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+// ProblemDetail is an RFC 7807 application/problem+json response body.
+// It omits the RFC's "instance" member: that member is meant to identify
+// the specific request that produced the problem, but ResponseWriter.Error
+// isn't given the request, so there is nothing meaningful to put there.
+type ProblemDetail struct {
+	Type   string            `json:"type"`
+	Title  string            `json:"title"`
+	Status int               `json:"status"`
+	Detail string            `json:"detail,omitempty"`
+	Errors []ValidationError `json:"errors,omitempty"`
+}
+
+// ProblemMapping describes how a domain error maps to a problem detail and
+// to ErrorResponse.ErrorCode.
+type ProblemMapping struct {
+	Type  string // stable problem "type" URI, e.g. "https://api.example.com/problems/not-found"
+	Title string // short, human-readable summary of the problem type
+	Code  string // stable machine-readable error code for ErrorResponse.ErrorCode
+}
+
+// problemRegistryMu guards problemRegistry, since RegisterProblemMapping can
+// run concurrently with lookupProblemMapping on every error response.
+var problemRegistryMu sync.RWMutex
+
+// problemRegistry maps domain errors to their problem metadata. It starts
+// pre-populated with this package's sentinel errors and can be extended or
+// overridden with RegisterProblemMapping. Access it only through
+// RegisterProblemMapping and lookupProblemMapping, which hold problemRegistryMu.
+var problemRegistry = map[error]ProblemMapping{
+	ErrNotFound:   {Type: "about:blank", Title: "Not Found", Code: "NOT_FOUND"},
+	ErrConflict:   {Type: "about:blank", Title: "Conflict", Code: "CONFLICT"},
+	ErrBadRequest: {Type: "about:blank", Title: "Bad Request", Code: "BAD_REQUEST"},
+}
+
+// RegisterProblemMapping registers (or overrides) the problem mapping used
+// for err by ProblemDetailsResponseWriter and the ErrorCode field written
+// by DefaultResponseWriter. err is matched with errors.Is, so wrapped
+// errors still resolve to their mapping. Safe for concurrent use.
+func RegisterProblemMapping(err error, mapping ProblemMapping) {
+	problemRegistryMu.Lock()
+	defer problemRegistryMu.Unlock()
+	problemRegistry[err] = mapping
+}
+
+// lookupProblemMapping returns the mapping registered for err, if any. Safe
+// for concurrent use.
+func lookupProblemMapping(err error) (ProblemMapping, bool) {
+	problemRegistryMu.RLock()
+	defer problemRegistryMu.RUnlock()
+	for candidate, mapping := range problemRegistry {
+		if errors.Is(err, candidate) {
+			return mapping, true
+		}
+	}
+	return ProblemMapping{}, false
+}
+
+// ProblemDetailsResponseWriter implements ResponseWriter as RFC 7807
+// application/problem+json error bodies, an alternative to
+// DefaultResponseWriter for consumers that want a standards-compliant
+// error contract. Successful responses are written as plain JSON, same as
+// DefaultResponseWriter.
+type ProblemDetailsResponseWriter struct{}
+
+func (w *ProblemDetailsResponseWriter) Response(writer http.ResponseWriter, v any, status int) error {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	if v == nil {
+		return nil
+	}
+
+	return json.NewEncoder(writer).Encode(v)
+}
+
+func (w *ProblemDetailsResponseWriter) Error(writer http.ResponseWriter, err error, status int) {
+	problem := ProblemDetail{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: err.Error(),
+	}
+
+	if mapping, ok := lookupProblemMapping(err); ok {
+		problem.Type = mapping.Type
+		problem.Title = mapping.Title
+	}
+
+	if detailed, ok := err.(interface{ Details() interface{} }); ok {
+		if validationErrors, ok := detailed.Details().([]ValidationError); ok {
+			problem.Errors = validationErrors
+		}
+	}
+
+	writer.Header().Set("Content-Type", "application/problem+json")
+	writer.WriteHeader(status)
+
+	if err := json.NewEncoder(writer).Encode(problem); err != nil {
+		slog.Error("unable to write problem details response",
+			"status", status,
+			"error", err,
+		)
+		http.Error(writer, ErrInternal.Error(), http.StatusInternalServerError)
+	}
+}
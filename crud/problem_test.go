@@ -0,0 +1,80 @@
+package crud
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRegisterProblemMapping_Concurrent exercises RegisterProblemMapping and
+// lookupProblemMapping from multiple goroutines at once; run with -race to
+// catch data races on problemRegistry.
+func TestRegisterProblemMapping_Concurrent(t *testing.T) {
+	domainErr := errors.New("concurrent test error")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterProblemMapping(domainErr, ProblemMapping{Type: "about:blank", Title: "Test", Code: "TEST"})
+		}()
+		go func() {
+			defer wg.Done()
+			lookupProblemMapping(domainErr)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestProblemDetailsResponseWriter_Error(t *testing.T) {
+	writer := &ProblemDetailsResponseWriter{}
+	w := httptest.NewRecorder()
+
+	writer.Error(w, ErrNotFound, http.StatusNotFound)
+
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+	var problem ProblemDetail
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, ErrNotFound.Error(), problem.Detail)
+	assert.Empty(t, problem.Errors)
+}
+
+// TestProblemDetailsResponseWriter_Error_ValidationFailure confirms a
+// *ValidationFailedError both populates the errors array via its Details()
+// method and resolves to the BAD_REQUEST mapping via its Unwrap() method.
+func TestProblemDetailsResponseWriter_Error_ValidationFailure(t *testing.T) {
+	writer := &ProblemDetailsResponseWriter{}
+	w := httptest.NewRecorder()
+
+	verr := &ValidationFailedError{Errors: []ValidationError{
+		{Field: "Name", Tag: "required", Message: "Name is required"},
+	}}
+
+	writer.Error(w, verr, http.StatusBadRequest)
+
+	var problem ProblemDetail
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "Bad Request", problem.Title)
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Len(t, problem.Errors, 1)
+	assert.Equal(t, "Name", problem.Errors[0].Field)
+}
+
+func TestValidationFailedError_Unwrap(t *testing.T) {
+	verr := &ValidationFailedError{}
+	assert.True(t, errors.Is(verr, ErrBadRequest))
+
+	mapping, ok := lookupProblemMapping(verr)
+	assert.True(t, ok)
+	assert.Equal(t, "BAD_REQUEST", mapping.Code)
+}
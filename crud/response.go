@@ -32,9 +32,10 @@ type ResponseWriter interface {
 
 // ErrorResponse represents the structure of an error response.
 type ErrorResponse struct {
-	Status  int         `json:"status"`            // HTTP status code
-	Message string      `json:"message"`           // human-readable error message
-	Details interface{} `json:"details,omitempty"` // additional error details
+	Status    int         `json:"status"`               // HTTP status code
+	Message   string      `json:"message"`              // human-readable error message
+	ErrorCode string      `json:"error_code,omitempty"` // stable machine-readable error code, see RegisterProblemMapping
+	Details   interface{} `json:"details,omitempty"`    // additional error details
 }
 
 type DefaultResponseWriter struct{}
@@ -56,6 +57,10 @@ func (w *DefaultResponseWriter) Error(writer http.ResponseWriter, err error, sta
 		Message: err.Error(),
 	}
 
+	if mapping, ok := lookupProblemMapping(err); ok {
+		res.ErrorCode = mapping.Code
+	}
+
 	// If the error implements the Details() method, include the details in the response
 	if detailed, ok := err.(interface{ Details() interface{} }); ok {
 		res.Details = detailed.Details()
@@ -0,0 +1,132 @@
+package crud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SortableQuery adds sort and sparse-fieldset query parameters. It is meant
+// to be embedded alongside PaginatedQuery or CursorQuery.
+type SortableQuery struct {
+	Sort   string `form:"sort"`   // comma-separated fields; prefix "-" for descending, e.g. "-created_at,name"
+	Fields string `form:"fields"` // comma-separated sparse fieldset projection, e.g. "id,name"
+}
+
+// SortSpec is a single parsed sort criterion.
+type SortSpec struct {
+	Field string
+	Desc  bool
+}
+
+// SortableFields is implemented by Listable querier types that support
+// sorting. It returns the whitelist of field names ListHandler accepts in
+// the "sort" query parameter.
+type SortableFields interface {
+	SortableFields() []string
+}
+
+// SortableListable is implemented by Listable types that accept sort
+// specifications alongside pagination. ListHandler uses it instead of
+// Listable.List when the query implements GetSort().
+//
+// The method is named ListSorted, not List, because a querier commonly
+// implements both Listable and SortableListable: Go does not allow a type
+// to declare two methods named List with different signatures.
+type SortableListable[T any, Q any] interface {
+	ListSorted(ctx context.Context, query Q, offset int, limit int, sort []SortSpec) ([]T, error)
+}
+
+// parseSortSpecs parses a comma-separated sort parameter ("field" or
+// "-field" for descending) and validates each field against allowed. On the
+// first field not present in allowed, it returns that field name and an
+// error.
+func parseSortSpecs(sort string, allowed []string) ([]SortSpec, string, error) {
+	if sort == "" {
+		return nil, "", nil
+	}
+
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, f := range allowed {
+		allowedSet[f] = true
+	}
+
+	parts := strings.Split(sort, ",")
+	specs := make([]SortSpec, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		field := p
+		desc := false
+		if strings.HasPrefix(p, "-") {
+			desc = true
+			field = p[1:]
+		}
+
+		if !allowedSet[field] {
+			return nil, field, fmt.Errorf("unknown sort field %q", field)
+		}
+
+		specs = append(specs, SortSpec{Field: field, Desc: desc})
+	}
+
+	return specs, "", nil
+}
+
+// parseFields parses a comma-separated sparse fieldset parameter.
+func parseFields(fields string) []string {
+	if fields == "" {
+		return nil
+	}
+
+	parts := strings.Split(fields, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+// applyFieldSelection projects items down to the requested fields by
+// marshaling them to JSON and keeping only the selected keys. It returns
+// items unchanged if fields is empty.
+func applyFieldSelection(items interface{}, fields []string) (interface{}, error) {
+	if len(fields) == 0 {
+		return items, nil
+	}
+
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		allowed[f] = true
+	}
+
+	projected := make([]map[string]json.RawMessage, len(raw))
+	for i, m := range raw {
+		p := make(map[string]json.RawMessage, len(fields))
+		for k, v := range m {
+			if allowed[k] {
+				p[k] = v
+			}
+		}
+		projected[i] = p
+	}
+
+	return projected, nil
+}
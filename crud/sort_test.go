@@ -0,0 +1,44 @@
+package crud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type SortCursorTestQuery struct {
+	CursorQuery
+	SortableQuery
+}
+
+func (q SortCursorTestQuery) GetCursor() CursorQuery {
+	return q.CursorQuery
+}
+
+func (q SortCursorTestQuery) GetSort() SortableQuery {
+	return q.SortableQuery
+}
+
+// TestListHandler_Handle_SortWithCursorRejected ensures a query combining
+// cursor pagination with a sort request is rejected rather than silently
+// ignoring the sort: Cursorable has no sort parameter to honor it with.
+func TestListHandler_Handle_SortWithCursorRejected(t *testing.T) {
+	querier := &MockQuerier[string, SortCursorTestQuery]{}
+	writer := &MockResponseWriter{}
+	handler := NewListHandler[string, SortCursorTestQuery](querier, writer)
+
+	querier.On("SortableFields").Return([]string{"name"})
+	writer.On("Error", mock.Anything, mock.MatchedBy(func(err *ValidationFailedError) bool {
+		return len(err.Errors) == 1 && err.Errors[0].Field == "sort"
+	}), http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("GET", "/?page_size=10&sort=name", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	writer.AssertExpectations(t)
+	querier.AssertNotCalled(t, "ListByCursor", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
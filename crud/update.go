@@ -0,0 +1,76 @@
+package crud
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Updatable defines the types that support updating an item by ID from
+// decoded input. T is the type of the updated item. In is the type of the
+// decoded request body. ID is the type of the item's identifier.
+type Updatable[T any, In any, ID any] interface {
+	// Update applies in to the item identified by id. Implementations
+	// should return ErrNotFound when no item has that id, and
+	// ErrConflict on conflicting updates.
+	Update(ctx context.Context, id ID, in In) (T, error)
+}
+
+// UpdateHandler is a generic HTTP handler for updating an item.
+// It provides path-parameter ID extraction, JSON body decoding, and
+// validation.
+type UpdateHandler[T any, In any, ID any] struct {
+	querier   Updatable[T, In, ID] // The querier to persist data to the database
+	validate  *validator.Validate  // Validator for the decoded request body
+	writer    ResponseWriter       // Response writer interface
+	extractID IDExtractor[ID]      // Extracts the item ID from the request
+}
+
+// NewUpdateHandler creates a new UpdateHandler with the provided querier,
+// response writer, and ID extractor. It initializes the validator with
+// default settings.
+func NewUpdateHandler[T any, In any, ID any](querier Updatable[T, In, ID], writer ResponseWriter, extractID IDExtractor[ID]) *UpdateHandler[T, In, ID] {
+	return &UpdateHandler[T, In, ID]{
+		querier:   querier,
+		validate:  validator.New(validator.WithRequiredStructEnabled()),
+		writer:    writer,
+		extractID: extractID,
+	}
+}
+
+// Handle processes HTTP requests for updating an item.
+func (h *UpdateHandler[T, In, ID]) Handle(w http.ResponseWriter, r *http.Request) {
+	id, err := h.extractID(r)
+	if err != nil {
+		slog.Error("unable to extract id", "error", err)
+		h.writer.Error(w, ErrBadRequest, http.StatusBadRequest)
+		return
+	}
+
+	var in In
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		slog.Error("unable to decode request body", "error", err)
+		h.writer.Error(w, ErrBadRequest, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.validate.Struct(in); err != nil {
+		slog.Error("unable to validate request body", "error", err)
+		h.writer.Error(w, &ValidationFailedError{Errors: ParseValidationErrors(err)}, http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.querier.Update(r.Context(), id, in)
+	if err != nil {
+		slog.Error("unable to update item", "error", err, "id", id)
+		writeDomainError(h.writer, w, err)
+		return
+	}
+
+	if err := h.writer.Response(w, item, http.StatusOK); err != nil {
+		slog.Error("unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,92 @@
+package crud
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUpdatable implements Updatable for testing.
+type MockUpdatable[T any, In any, ID any] struct {
+	mock.Mock
+}
+
+func (m *MockUpdatable[T, In, ID]) Update(ctx context.Context, id ID, in In) (T, error) {
+	args := m.Called(ctx, id, in)
+	return args.Get(0).(T), args.Error(1)
+}
+
+func TestUpdateHandler_Handle_ValidRequest(t *testing.T) {
+	querier := &MockUpdatable[string, CreateTestInput, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewUpdateHandler[string, CreateTestInput, string](querier, writer, extractIDString)
+
+	querier.On("Update", mock.Anything, "42", CreateTestInput{Name: "widget"}).Return("item1", nil)
+	writer.On("Response", mock.Anything, "item1", http.StatusOK).Return(nil)
+
+	req := httptest.NewRequest("PUT", "/items/42", bytes.NewBufferString(`{"name":"widget"}`))
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
+
+func TestUpdateHandler_Handle_ExtractIDError(t *testing.T) {
+	querier := &MockUpdatable[string, CreateTestInput, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewUpdateHandler[string, CreateTestInput, string](querier, writer, extractIDString)
+
+	writer.On("Error", mock.Anything, ErrBadRequest, http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("PUT", "/items/", bytes.NewBufferString(`{"name":"widget"}`))
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
+
+func TestUpdateHandler_Handle_ValidationFailure(t *testing.T) {
+	querier := &MockUpdatable[string, CreateTestInput, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewUpdateHandler[string, CreateTestInput, string](querier, writer, extractIDString)
+
+	writer.On("Error", mock.Anything, mock.MatchedBy(func(err *ValidationFailedError) bool {
+		return len(err.Errors) == 1 && err.Errors[0].Field == "Name"
+	}), http.StatusBadRequest).Return()
+
+	req := httptest.NewRequest("PUT", "/items/42", bytes.NewBufferString(`{}`))
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	writer.AssertExpectations(t)
+}
+
+func TestUpdateHandler_Handle_NotFound(t *testing.T) {
+	querier := &MockUpdatable[string, CreateTestInput, string]{}
+	writer := &MockResponseWriter{}
+	handler := NewUpdateHandler[string, CreateTestInput, string](querier, writer, extractIDString)
+
+	querier.On("Update", mock.Anything, "42", CreateTestInput{Name: "widget"}).Return("", ErrNotFound)
+	writer.On("Error", mock.Anything, ErrNotFound, http.StatusNotFound).Return()
+
+	req := httptest.NewRequest("PUT", "/items/42", bytes.NewBufferString(`{"name":"widget"}`))
+	req.SetPathValue("id", "42")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	querier.AssertExpectations(t)
+	writer.AssertExpectations(t)
+}
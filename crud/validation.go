@@ -0,0 +1,61 @@
+package crud
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationError describes a single field that failed validation.
+type ValidationError struct {
+	Field   string `json:"field"`   // struct field name, e.g. "Page"; or query parameter name, e.g. "page_size", for checks ListHandler makes itself
+	Tag     string `json:"tag"`     // validator tag that failed, e.g. "max"
+	Message string `json:"message"` // human-readable description of the failure
+	Param   string `json:"param"`   // parameter of the tag, e.g. "100" for "max=100"
+}
+
+// ParseValidationErrors walks a validator.ValidationErrors and returns one
+// ValidationError per failed field. It returns nil if err does not wrap
+// validator.ValidationErrors.
+func ParseValidationErrors(err error) []ValidationError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	out := make([]ValidationError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		out = append(out, ValidationError{
+			Field:   fe.Field(),
+			Tag:     fe.Tag(),
+			Message: fe.Error(),
+			Param:   fe.Param(),
+		})
+	}
+
+	return out
+}
+
+// ValidationFailedError wraps field-level validation failures so they can be
+// surfaced through the ResponseWriter.Error Details() contract.
+type ValidationFailedError struct {
+	Errors []ValidationError
+}
+
+// Error implements the error interface. The message intentionally matches
+// ErrBadRequest so existing callers that compare against it still work.
+func (e *ValidationFailedError) Error() string {
+	return ErrBadRequest.Error()
+}
+
+// Unwrap makes errors.Is(err, ErrBadRequest) true for a *ValidationFailedError,
+// so it resolves to the same problem mapping as ErrBadRequest in
+// lookupProblemMapping instead of falling through to the generic 400 default.
+func (e *ValidationFailedError) Unwrap() error {
+	return ErrBadRequest
+}
+
+// Details returns the field-level validation errors for DefaultResponseWriter.Error.
+func (e *ValidationFailedError) Details() interface{} {
+	return e.Errors
+}
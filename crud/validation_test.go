@@ -0,0 +1,43 @@
+package crud
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type validationTestStruct struct {
+	Name string `validate:"required"`
+	Age  int    `validate:"min=18"`
+}
+
+func TestParseValidationErrors(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	err := v.Struct(validationTestStruct{Age: 10})
+	assert.Error(t, err)
+
+	errs := ParseValidationErrors(err)
+	assert.Len(t, errs, 2)
+
+	byField := make(map[string]ValidationError, len(errs))
+	for _, e := range errs {
+		byField[e.Field] = e
+	}
+
+	assert.Equal(t, "required", byField["Name"].Tag)
+	assert.Equal(t, "min", byField["Age"].Tag)
+	assert.Equal(t, "18", byField["Age"].Param)
+}
+
+func TestParseValidationErrors_NotValidationErrors(t *testing.T) {
+	assert.Nil(t, ParseValidationErrors(assert.AnError))
+}
+
+func TestValidationFailedError(t *testing.T) {
+	errs := []ValidationError{{Field: "Name", Tag: "required", Message: "Name is required"}}
+	vErr := &ValidationFailedError{Errors: errs}
+
+	assert.Equal(t, ErrBadRequest.Error(), vErr.Error())
+	assert.Equal(t, errs, vErr.Details())
+}